@@ -0,0 +1,50 @@
+package dependencyresolver
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	"github.com/rotisserie/eris"
+	. "github.com/solo-io/go-utils/versionutils"
+)
+
+// GCSResolverName is the registered name of the gcsResolver, the original resolution strategy
+// that scrapes a plaintext dependency manifest out of a public GCS bucket.
+const GCSResolverName = "gcs"
+
+func init() {
+	Register(GCSResolverName, &gcsResolver{client: http.DefaultClient})
+}
+
+type gcsResolver struct {
+	client *http.Client
+}
+
+var dependencyLineRegex = regexp.MustCompile(`.*gloo.*(v.*)`)
+
+func (g *gcsResolver) Resolve(ctx context.Context, enterpriseVersion *Version) (*Version, error) {
+	versionTag := enterpriseVersion.String()
+	dependencyUrl := fmt.Sprintf("https://storage.googleapis.com/gloo-ee-dependencies/%s/dependencies", versionTag[1:])
+	request, err := http.NewRequestWithContext(ctx, "GET", dependencyUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := g.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := dependencyLineRegex.FindStringSubmatch(string(body))
+	if len(matches) != 2 {
+		return nil, eris.Errorf("unable to get gloo dependency for gloo enterprise version %s\n response from google storage API: %s", versionTag, string(body))
+	}
+	return ParseVersion(matches[1])
+}
@@ -0,0 +1,79 @@
+package dependencyresolver
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/rotisserie/eris"
+	. "github.com/solo-io/go-utils/versionutils"
+	"gopkg.in/yaml.v2"
+)
+
+// GithubAssetResolverName is the registered name of the githubAssetResolver, which reads the
+// dependency version out of a release asset (e.g. metadata.yaml) attached to the enterprise
+// release.
+const GithubAssetResolverName = "github-release-asset"
+
+// DefaultAssetName is the release asset this resolver downloads when none is configured.
+const DefaultAssetName = "metadata.yaml"
+
+func init() {
+	Register(GithubAssetResolverName, NewGithubAssetResolver(github.NewClient(nil), "solo-io", "solo-projects", DefaultAssetName))
+}
+
+type githubAssetResolver struct {
+	client    *github.Client
+	owner     string
+	repo      string
+	assetName string
+}
+
+// NewGithubAssetResolver constructs a DependencyResolver that downloads assetName from the
+// enterprise release tagged with the enterprise version, and parses its `gloo:` field.
+func NewGithubAssetResolver(client *github.Client, owner, repo, assetName string) DependencyResolver {
+	return &githubAssetResolver{client: client, owner: owner, repo: repo, assetName: assetName}
+}
+
+type releaseMetadata struct {
+	Gloo string `yaml:"gloo"`
+}
+
+func (g *githubAssetResolver) Resolve(ctx context.Context, enterpriseVersion *Version) (*Version, error) {
+	release, _, err := g.client.Repositories.GetReleaseByTag(ctx, g.owner, g.repo, enterpriseVersion.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var assetID int64
+	for _, asset := range release.Assets {
+		if asset.GetName() == g.assetName {
+			assetID = asset.GetID()
+			break
+		}
+	}
+	if assetID == 0 {
+		return nil, eris.Errorf("release %s has no asset named %q", enterpriseVersion.String(), g.assetName)
+	}
+
+	rc, _, err := g.client.Repositories.DownloadReleaseAsset(ctx, g.owner, g.repo, assetID, http.DefaultClient)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	body, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata releaseMetadata
+	if err := yaml.Unmarshal(body, &metadata); err != nil {
+		return nil, err
+	}
+	if metadata.Gloo == "" {
+		return nil, eris.Errorf("%s for %s did not contain a gloo field", g.assetName, enterpriseVersion.String())
+	}
+	return ParseVersion(metadata.Gloo)
+}
@@ -0,0 +1,18 @@
+package dependencyresolver
+
+import "encoding/json"
+
+// imageConfigFile is the subset of the OCI image config JSON needed to read a label.
+type imageConfigFile struct {
+	Config struct {
+		Labels map[string]string `json:"Labels"`
+	} `json:"config"`
+}
+
+func configLabel(configJSON []byte, label string) (string, error) {
+	var config imageConfigFile
+	if err := json.Unmarshal(configJSON, &config); err != nil {
+		return "", err
+	}
+	return config.Config.Labels[label], nil
+}
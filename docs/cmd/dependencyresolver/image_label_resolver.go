@@ -0,0 +1,49 @@
+package dependencyresolver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/rotisserie/eris"
+	. "github.com/solo-io/go-utils/versionutils"
+)
+
+// ImageLabelResolverName is the registered name of the imageLabelResolver, which pulls an image's
+// manifest and reads the dependency version from an OCI label.
+const ImageLabelResolverName = "image-label"
+
+// DefaultGlooVersionLabel is the OCI label this resolver reads when none is configured.
+const DefaultGlooVersionLabel = "io.solo.gloo.version"
+
+func init() {
+	Register(ImageLabelResolverName, NewImageLabelResolver("quay.io/solo-io/gloo-ee", DefaultGlooVersionLabel))
+}
+
+type imageLabelResolver struct {
+	imageRepo string
+	label     string
+}
+
+// NewImageLabelResolver constructs a DependencyResolver that pulls imageRepo:<enterpriseVersion>
+// and reads the dependency version from the given OCI label.
+func NewImageLabelResolver(imageRepo, label string) DependencyResolver {
+	return &imageLabelResolver{imageRepo: imageRepo, label: label}
+}
+
+func (i *imageLabelResolver) Resolve(ctx context.Context, enterpriseVersion *Version) (*Version, error) {
+	ref := fmt.Sprintf("%s:%s", i.imageRepo, enterpriseVersion.String())
+	config, err := crane.Config(ref, crane.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	labelValue, err := configLabel(config, i.label)
+	if err != nil {
+		return nil, err
+	}
+	if labelValue == "" {
+		return nil, eris.Errorf("image %s has no %q label", ref, i.label)
+	}
+	return ParseVersion(labelValue)
+}
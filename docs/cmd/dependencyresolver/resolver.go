@@ -0,0 +1,47 @@
+// Package dependencyresolver determines which Gloo Edge version a given Gloo Enterprise release
+// depends on, via pluggable resolution strategies.
+package dependencyresolver
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	. "github.com/solo-io/go-utils/versionutils"
+)
+
+// DependencyResolver resolves the Gloo Edge (open source) version that a given Gloo Enterprise
+// version depends on.
+type DependencyResolver interface {
+	Resolve(ctx context.Context, enterpriseVersion *Version) (*Version, error)
+}
+
+var (
+	registry = map[string]DependencyResolver{}
+
+	UnknownResolverError = func(name string) error {
+		return eris.Errorf("unknown dependency resolver %q, registered resolvers: %v", name, registeredNames())
+	}
+)
+
+// Register adds a DependencyResolver to the registry under name, so it can be selected via the
+// `--dependency-resolver` flag. Intended to be called from init() by each implementation.
+func Register(name string, resolver DependencyResolver) {
+	registry[name] = resolver
+}
+
+// Get looks up a registered DependencyResolver by name.
+func Get(name string) (DependencyResolver, error) {
+	resolver, ok := registry[name]
+	if !ok {
+		return nil, UnknownResolverError(name)
+	}
+	return resolver, nil
+}
+
+func registeredNames() []string {
+	var names []string
+	for name := range registry {
+		names = append(names, name)
+	}
+	return names
+}
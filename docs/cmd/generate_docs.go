@@ -3,20 +3,18 @@ package main
 import (
 	"context"
 	"fmt"
-	"net/http"
-	"io/ioutil"
 	"os"
-	"regexp"
 
-	"github.com/Masterminds/semver/v3"
-	"github.com/google/go-github/v32/github"
 	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/docs/cmd/dependencyresolver"
+	"github.com/solo-io/gloo/docs/cmd/ghclient"
+	"github.com/solo-io/gloo/docs/cmd/releasenotes"
 	. "github.com/solo-io/gloo/docs/cmd/securityscanutils"
+	"github.com/solo-io/gloo/docs/cmd/version"
 	"github.com/solo-io/go-utils/changelogutils/changelogdocutils"
 	"github.com/solo-io/go-utils/githubutils"
 	. "github.com/solo-io/go-utils/versionutils"
 	"github.com/spf13/cobra"
-	"golang.org/x/oauth2"
 )
 
 func main() {
@@ -29,8 +27,16 @@ func main() {
 }
 
 type options struct {
-	ctx              context.Context
-	HugoDataSoloOpts HugoDataSoloOpts
+	ctx                   context.Context
+	HugoDataSoloOpts      HugoDataSoloOpts
+	skipVersionCheck      bool
+	dependencyResolver    string
+	securityScanFormat    string
+	securityScanOutputDir string
+	versionConstraint     string
+	contract              string
+	includePrereleases    bool
+	concurrency           int
 }
 
 type HugoDataSoloOpts struct {
@@ -47,6 +53,12 @@ func rootApp(ctx context.Context) *cobra.Command {
 	}
 	app := &cobra.Command{
 		Use: "docs-util",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if opts.skipVersionCheck || os.Getenv(version.SkipEnvVar) != "" {
+				return
+			}
+			version.WarnIfNewerRelease(ctx, "solo-io", "gloo")
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			return nil
@@ -54,15 +66,28 @@ func rootApp(ctx context.Context) *cobra.Command {
 	}
 	app.AddCommand(changelogMdFromGithubCmd(opts))
 	app.AddCommand(securityScanMdFromCmd(opts))
+	app.AddCommand(versionCmd())
 
 	app.PersistentFlags().StringVar(&opts.HugoDataSoloOpts.version, "version", "", "version of docs and code")
 	app.PersistentFlags().StringVar(&opts.HugoDataSoloOpts.product, "product", "gloo", "product to which the docs refer (defaults to gloo)")
 	app.PersistentFlags().BoolVar(&opts.HugoDataSoloOpts.noScope, "no-scope", false, "if set, will not nest the served docs by product or version")
 	app.PersistentFlags().BoolVar(&opts.HugoDataSoloOpts.callLatest, "call-latest", false, "if set, will use the string 'latest' in the scope, rather than the particular release version")
+	app.PersistentFlags().BoolVar(&opts.skipVersionCheck, "skip-version-check", false, "if set, will not check GitHub for a newer docs-util release")
 
 	return app
 }
 
+func versionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "print the docs-util version",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println(version.String())
+			return nil
+		},
+	}
+}
+
 func securityScanMdFromCmd(opts *options) *cobra.Command {
 	app := &cobra.Command{
 		Use:   "gen-security-scan-md",
@@ -71,9 +96,16 @@ func securityScanMdFromCmd(opts *options) *cobra.Command {
 			if os.Getenv(skipSecurityScan) != "" {
 				return nil
 			}
-			return generateSecurityScanMd(args)
+			return generateSecurityScanMd(args, opts)
 		},
 	}
+	app.Flags().StringVar(&opts.securityScanFormat, "format", string(FormatLegacy),
+		"scan output format to parse: sarif, cyclonedx, or legacy")
+	app.Flags().StringVar(&opts.securityScanOutputDir, "output-dir", ".", "directory to write the rendered security scan docs to")
+	app.Flags().StringVar(&opts.versionConstraint, "version-constraint", ">= 1.4.0", "semver constraint releases must satisfy to be included")
+	app.Flags().StringVar(&opts.contract, "contract", "", "if set, only include releases whose metadata.yaml declares this API contract")
+	app.Flags().BoolVar(&opts.includePrereleases, "include-prereleases", false, "if set, include prerelease tags")
+	app.Flags().IntVar(&opts.concurrency, "concurrency", ghclient.DefaultConcurrency, "number of releases to process concurrently")
 	return app
 }
 
@@ -85,9 +117,12 @@ func changelogMdFromGithubCmd(opts *options) *cobra.Command {
 			if os.Getenv(skipChangelogGeneration) != "" {
 				return nil
 			}
-			return generateChangelogMd(args)
+			return generateChangelogMd(args, opts.dependencyResolver)
 		},
 	}
+	app.Flags().StringVar(&opts.dependencyResolver, "dependency-resolver", dependencyresolver.GCSResolverName,
+		"strategy used to resolve the Gloo Edge version a Gloo Enterprise release depends on")
+	app.AddCommand(releasenotes.Cmd(opts.ctx))
 	return app
 }
 
@@ -119,45 +154,13 @@ var (
 	}
 )
 
-// Default FindDependentVersionFn (used for Gloo Edge)
-func FindDependentVersionFn(enterpriseVersion *Version) (*Version, error) {
-	versionTag := enterpriseVersion.String()
-	dependencyUrl := fmt.Sprintf("https://storage.googleapis.com/gloo-ee-dependencies/%s/dependencies", versionTag[1:])
-	request, err := http.NewRequest("GET", dependencyUrl, nil)
-	if err != nil {
-		return nil, err
-	}
-	response, err := http.DefaultClient.Do(request)
-	if err != nil {
-		return nil, err
-	}
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-	re, err := regexp.Compile(`.*gloo.*(v.*)`)
-	if err != nil {
-		return nil, err
-	}
-	matches := re.FindStringSubmatch(string(body))
-	if len(matches) != 2 {
-		return nil, eris.Errorf("unable to get gloo dependency for gloo enterprise version %s\n response from google storage API: %s", versionTag, string(body))
-	}
-	glooVersionTag := matches[1]
-	version, err := ParseVersion(glooVersionTag)
-	if err != nil {
-		return nil, err
-	}
-	return version, nil
-}
-
 // Generates changelog for releases as fetched from Github
 // Github defaults to a chronological order
-func generateChangelogMd(args []string) error {
+func generateChangelogMd(args []string, dependencyResolverName string) error {
 	if len(args) != 1 {
 		return InvalidInputError(fmt.Sprintf("%v", len(args)-1))
 	}
-	client := github.NewClient(nil)
+	client := ghclient.New(context.Background(), os.Getenv("GITHUB_TOKEN"), 0)
 	target := args[0]
 	switch target {
 	case glooDocGen:
@@ -168,7 +171,7 @@ func generateChangelogMd(args []string) error {
 		}
 		fmt.Println(out)
 	case glooEDocGen:
-		err := generateGlooEChangelog()
+		err := generateGlooEChangelog(dependencyResolverName)
 		if err != nil {
 			return err
 		}
@@ -180,18 +183,21 @@ func generateChangelogMd(args []string) error {
 }
 
 // Fetches Gloo Enterprise releases, merges in open source release notes, and orders them by version
-func generateGlooEChangelog() error {
+func generateGlooEChangelog(dependencyResolverName string) error {
 	// Initialize Auth
 	ctx := context.Background()
 	if os.Getenv("GITHUB_TOKEN") == "" {
 		return MissingGithubTokenError(skipChangelogGeneration)
 	}
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: os.Getenv("GITHUB_TOKEN")},
-	)
-	tc := oauth2.NewClient(ctx, ts)
-	client := github.NewClient(tc)
-	generator := changelogdocutils.NewMergedReleaseGenerator(client, "solo-io", glooEnterpriseRepo, glooOpenSourceRepo, FindDependentVersionFn)
+	client := ghclient.New(ctx, os.Getenv("GITHUB_TOKEN"), 0)
+	resolver, err := dependencyresolver.Get(dependencyResolverName)
+	if err != nil {
+		return err
+	}
+	findDependentVersionFn := func(enterpriseVersion *Version) (*Version, error) {
+		return resolver.Resolve(ctx, enterpriseVersion)
+	}
+	generator := changelogdocutils.NewMergedReleaseGenerator(client, "solo-io", glooEnterpriseRepo, glooOpenSourceRepo, findDependentVersionFn)
 	out, err := generator.GenerateJSON(context.Background())
 	if err != nil {
 		return err
@@ -201,19 +207,20 @@ func generateGlooEChangelog() error {
 }
 
 // Generates security scan log for releases
-func generateSecurityScanMd(args []string) error {
+func generateSecurityScanMd(args []string, opts *options) error {
 	if len(args) != 1 {
 		return InvalidInputError(fmt.Sprintf("%v", len(args)-1))
 	}
 	target := args[0]
+	buildOpts := BuildOptions{Format: Format(opts.securityScanFormat), OutputDir: opts.securityScanOutputDir, Concurrency: opts.concurrency}
 	var (
 		err error
 	)
 	switch target {
 	case glooDocGen:
-		err = generateSecurityScanGloo(context.Background())
+		err = generateSecurityScanGloo(context.Background(), opts, buildOpts)
 	case glooEDocGen:
-		err = generateSecurityScanGlooE(context.Background())
+		err = generateSecurityScanGlooE(context.Background(), opts, buildOpts)
 	default:
 		return InvalidInputError(target)
 	}
@@ -221,58 +228,46 @@ func generateSecurityScanMd(args []string) error {
 	return err
 }
 
-func generateSecurityScanGloo(ctx context.Context) error {
-	client := github.NewClient(nil)
+func generateSecurityScanGloo(ctx context.Context, opts *options, buildOpts BuildOptions) error {
+	client := ghclient.New(ctx, os.Getenv("GITHUB_TOKEN"), 0)
 	allReleases, err := githubutils.GetAllRepoReleases(ctx, client, "solo-io", glooOpenSourceRepo)
 	if err != nil {
 		return err
 	}
 	githubutils.SortReleasesBySemver(allReleases)
+
+	selector, err := NewReleaseSelector(client, "solo-io", glooOpenSourceRepo, opts.versionConstraint, opts.contract, opts.includePrereleases, opts.concurrency)
 	if err != nil {
 		return err
 	}
-
-	var tagNames []string
-	for _, release := range allReleases {
-		// ignore beta releases when display security scan results
-		test, err := semver.NewVersion(release.GetTagName())
-		stableOnlyConstraint, _ := semver.NewConstraint(">= 1.4.0")
-		if err == nil && stableOnlyConstraint.Check(test) {
-			tagNames = append(tagNames, release.GetTagName())
-		}
+	tagNames, err := selector.Select(ctx, allReleases)
+	if err != nil {
+		return err
 	}
 
-	return BuildSecurityScanReportGloo(tagNames)
+	return BuildSecurityScanReportGloo(tagNames, buildOpts)
 }
 
-func generateSecurityScanGlooE(ctx context.Context) error {
+func generateSecurityScanGlooE(ctx context.Context, opts *options, buildOpts BuildOptions) error {
 	// Initialize Auth
 	if os.Getenv("GITHUB_TOKEN") == "" {
 		return MissingGithubTokenError(skipSecurityScan)
 	}
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: os.Getenv("GITHUB_TOKEN")},
-	)
-	tc := oauth2.NewClient(ctx, ts)
-	client := github.NewClient(tc)
+	client := ghclient.New(ctx, os.Getenv("GITHUB_TOKEN"), 0)
 	allReleases, err := githubutils.GetAllRepoReleases(ctx, client, "solo-io", glooEnterpriseRepo)
 	if err != nil {
 		return err
 	}
 	githubutils.SortReleasesBySemver(allReleases)
+
+	selector, err := NewReleaseSelector(client, "solo-io", glooEnterpriseRepo, opts.versionConstraint, opts.contract, opts.includePrereleases, opts.concurrency)
 	if err != nil {
 		return err
 	}
-
-	var tagNames []string
-	for _, release := range allReleases {
-		// ignore beta releases when display security scan results
-		test, err := semver.NewVersion(release.GetTagName())
-		stableOnlyConstraint, _ := semver.NewConstraint(">= 1.4.0")
-		if err == nil && stableOnlyConstraint.Check(test) {
-			tagNames = append(tagNames, release.GetTagName())
-		}
+	tagNames, err := selector.Select(ctx, allReleases)
+	if err != nil {
+		return err
 	}
 
-	return BuildSecurityScanReportGlooE(tagNames)
+	return BuildSecurityScanReportGlooE(tagNames, buildOpts)
 }
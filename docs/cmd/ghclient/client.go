@@ -0,0 +1,42 @@
+// Package ghclient provides a shared GitHub client for the docs-util CLI with retry/backoff,
+// an on-disk ETag cache, and a concurrency-bounded release iteration helper, so a full docs build
+// doesn't perform hundreds of serial, unauthenticated-rate-limit-prone GitHub calls.
+package ghclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
+)
+
+// DefaultMaxRetries is used when New is called without an explicit retry count.
+const DefaultMaxRetries = 5
+
+// New returns a github.Client whose underlying http.Client retries on rate limiting and transient
+// errors with exponential backoff (honoring the Retry-After header), caches responses on disk
+// keyed by request URL, and is authenticated with token when non-empty.
+func New(ctx context.Context, token string, maxRetries int) *github.Client {
+	if maxRetries <= 0 {
+		maxRetries = DefaultMaxRetries
+	}
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = maxRetries
+	retryClient.Logger = nil
+	retryClient.CheckRetry = retryablehttp.DefaultRetryPolicy
+
+	transport := retryClient.StandardClient().Transport
+	transport = &etagCachingTransport{next: transport}
+	if token != "" {
+		transport = &oauth2.Transport{
+			Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}),
+			Base:   transport,
+		}
+	}
+
+	httpClient := &http.Client{Transport: transport}
+	return github.NewClient(httpClient)
+}
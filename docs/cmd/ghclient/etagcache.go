@@ -0,0 +1,112 @@
+package ghclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// etagCacheEntry is the on-disk representation of a cached response.
+type etagCacheEntry struct {
+	ETag string
+	Body []byte
+}
+
+// etagCachingTransport is an http.RoundTripper that caches GitHub API responses on disk keyed by
+// request URL. It sends `If-None-Match` on every GET it has a cached ETag for and, on a
+// `304 Not Modified`, serves the
+// cached body instead of round-tripping a fresh one - letting re-runs of gen-changelog-md and
+// gen-security-scan-md short-circuit instead of re-downloading unchanged data.
+type etagCachingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *etagCachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.next.RoundTrip(req)
+	}
+
+	path := cachePath(req.URL.String())
+	cached, hasCached := readEtagCache(path)
+	if hasCached {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && hasCached {
+		resp.Body.Close()
+		resp.StatusCode = http.StatusOK
+		resp.Status = "200 OK"
+		resp.Body = ioutil.NopCloser(bytes.NewReader(cached.Body))
+		return resp, nil
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			body, err := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err == nil {
+				_ = writeEtagCache(path, &etagCacheEntry{ETag: etag, Body: body})
+				resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+func cacheDir() (string, error) {
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		cacheHome = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(cacheHome, "gloo-docs-util", "etag-cache"), nil
+}
+
+func cachePath(requestURL string) string {
+	dir, err := cacheDir()
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(requestURL))
+	return filepath.Join(dir, hex.EncodeToString(sum[:]))
+}
+
+func readEtagCache(path string) (*etagCacheEntry, bool) {
+	if path == "" {
+		return nil, false
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	sep := bytes.IndexByte(data, '\n')
+	if sep < 0 {
+		return nil, false
+	}
+	return &etagCacheEntry{ETag: string(data[:sep]), Body: data[sep+1:]}, true
+}
+
+func writeEtagCache(path string, entry *etagCacheEntry) error {
+	if path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data := append([]byte(entry.ETag+"\n"), entry.Body...)
+	return ioutil.WriteFile(path, data, 0644)
+}
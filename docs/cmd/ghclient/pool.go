@@ -0,0 +1,47 @@
+package ghclient
+
+import (
+	"context"
+
+	"github.com/google/go-github/v32/github"
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultConcurrency is used by ForEachRelease and ForEachTag when concurrency <= 0.
+const DefaultConcurrency = 4
+
+// ForEachRelease runs fn for every release in releases using a worker pool bounded to
+// concurrency, returning the first error encountered (if any) after all in-flight work completes.
+func ForEachRelease(ctx context.Context, releases []*github.RepositoryRelease, concurrency int, fn func(ctx context.Context, release *github.RepositoryRelease) error) error {
+	return forEach(ctx, len(releases), concurrency, func(ctx context.Context, i int) error {
+		return fn(ctx, releases[i])
+	})
+}
+
+// ForEachTag runs fn for every tag name in tagNames using a worker pool bounded to concurrency,
+// returning the first error encountered (if any) after all in-flight work completes.
+func ForEachTag(ctx context.Context, tagNames []string, concurrency int, fn func(ctx context.Context, tagName string) error) error {
+	return forEach(ctx, len(tagNames), concurrency, func(ctx context.Context, i int) error {
+		return fn(ctx, tagNames[i])
+	})
+}
+
+func forEach(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int) error) error {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	sem := make(chan struct{}, concurrency)
+
+	for i := 0; i < n; i++ {
+		i := i
+		sem <- struct{}{}
+		group.Go(func() error {
+			defer func() { <-sem }()
+			return fn(groupCtx, i)
+		})
+	}
+
+	return group.Wait()
+}
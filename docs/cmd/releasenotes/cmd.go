@@ -0,0 +1,74 @@
+package releasenotes
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/docs/cmd/ghclient"
+	"github.com/spf13/cobra"
+)
+
+// Cmd returns the `gen-composed-release-notes` subcommand, which builds a changelog from git
+// history and PR metadata rather than from GitHub Release pages.
+func Cmd(ctx context.Context) *cobra.Command {
+	var (
+		repoPath string
+		owner    string
+		repo     string
+		fromRef  string
+		toRef    string
+		outMd    string
+		outJSON  string
+	)
+	cmd := &cobra.Command{
+		Use:   "gen-composed-release-notes",
+		Short: "generate a changelog from git history and PR metadata for a version range",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if os.Getenv("GITHUB_TOKEN") == "" {
+				return eris.New("Must set GITHUB_TOKEN to fetch PR metadata")
+			}
+			localRepo, err := git.PlainOpen(repoPath)
+			if err != nil {
+				return err
+			}
+			ghClient := NewGithubPRClient(ghclient.New(ctx, os.Getenv("GITHUB_TOKEN"), 0), owner, repo)
+			composer := NewComposer(localRepo, owner, repo, ghClient, nil)
+			return run(ctx, composer, fromRef, toRef, outMd, outJSON)
+		},
+	}
+	cmd.Flags().StringVar(&repoPath, "repo-path", ".", "path to the local git checkout to walk")
+	cmd.Flags().StringVar(&owner, "owner", "solo-io", "github organization that owns the repo")
+	cmd.Flags().StringVar(&repo, "repo", "gloo", "github repo to fetch PR metadata from")
+	cmd.Flags().StringVar(&fromRef, "from", "", "tag or ref to start the range from (exclusive); required, e.g. the previous release tag")
+	cmd.Flags().StringVar(&toRef, "to", "", "tag or ref to end the range at (defaults to HEAD)")
+	cmd.Flags().StringVar(&outMd, "out-md", "", "path to write the rendered Markdown changelog")
+	cmd.Flags().StringVar(&outJSON, "out-json", "", "path to write the machine-readable JSON changelog")
+	cmd.MarkFlagRequired("from")
+	return cmd
+}
+
+// run composes the changelog and writes both output formats; split out from RunE for testability.
+func run(ctx context.Context, composer *Composer, fromRef, toRef, outMd, outJSON string) error {
+	changelog, err := composer.Generate(ctx, fromRef, toRef)
+	if err != nil {
+		return err
+	}
+	if outMd != "" {
+		if err := ioutil.WriteFile(outMd, []byte(RenderMarkdown(changelog)), 0644); err != nil {
+			return err
+		}
+	}
+	if outJSON != "" {
+		jsonOut, err := RenderJSON(changelog)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(outJSON, []byte(jsonOut), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
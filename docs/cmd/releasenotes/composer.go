@@ -0,0 +1,266 @@
+// Package releasenotes builds changelogs directly from git history and PR
+// metadata, as an alternative to sourcing entries from GitHub Release pages.
+package releasenotes
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/rotisserie/eris"
+)
+
+var (
+	mergeCommitPRRegex  = regexp.MustCompile(`Merge pull request #(\d+)`)
+	squashCommitPRRegex = regexp.MustCompile(`\(#(\d+)\)$`)
+
+	NoSuchTagError = func(tag string) error {
+		return eris.Errorf("no commit found for tag or ref %q", tag)
+	}
+	MissingFromRefError = eris.New("fromRef must be set to the previous tag or ref to start the range from; it cannot be inferred")
+)
+
+// Section is a named group that composed entries are bucketed into, e.g. "Features" or "Bug Fixes".
+type Section string
+
+const (
+	SectionFeatures Section = "Features"
+	SectionBugFixes Section = "Bug Fixes"
+	SectionBreaking Section = "Breaking Changes"
+	SectionInfra    Section = "Infra"
+	SectionOther    Section = "Other"
+)
+
+// SectionRule maps a label or title prefix to the section it should be grouped under.
+type SectionRule struct {
+	Prefix  string
+	Section Section
+}
+
+// DefaultTaxonomy is the section-grouping taxonomy used when a caller does not supply their own.
+// Rules are tried in order and the first match wins, so the breaking-change rules come first.
+var DefaultTaxonomy = []SectionRule{
+	{Prefix: ":warning:", Section: SectionBreaking},
+	{Prefix: "BREAKING CHANGE:", Section: SectionBreaking},
+	{Prefix: ":sparkles:", Section: SectionFeatures},
+	{Prefix: "feat:", Section: SectionFeatures},
+	{Prefix: ":bug:", Section: SectionBugFixes},
+	{Prefix: "fix:", Section: SectionBugFixes},
+	{Prefix: ":seedling:", Section: SectionInfra},
+}
+
+// Entry is a single composed changelog entry, associated with the PR that introduced it (if any).
+type Entry struct {
+	CommitSHA string   `json:"commitSha"`
+	PRNumber  int      `json:"prNumber,omitempty"`
+	Title     string   `json:"title"`
+	Body      string   `json:"body,omitempty"`
+	Author    string   `json:"author,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Section   Section  `json:"section"`
+}
+
+// ComposedChangelog is the full result of composing a changelog for a single version range.
+type ComposedChangelog struct {
+	Owner        string              `json:"owner"`
+	Repo         string              `json:"repo"`
+	FromRef      string              `json:"fromRef"`
+	ToRef        string              `json:"toRef"`
+	Sections     map[Section][]Entry `json:"sections"`
+	Contributors []string            `json:"contributors"`
+}
+
+// Composer builds a ComposedChangelog from a local git repository and the GitHub API.
+type Composer struct {
+	repo     *git.Repository
+	owner    string
+	repoName string
+	ghClient PRClient
+	taxonomy []SectionRule
+}
+
+// NewComposer constructs a Composer over the given repository, using ghClient to fetch PR
+// metadata, owner/repoName to build links back to GitHub, and taxonomy to group entries into
+// sections. If taxonomy is nil, DefaultTaxonomy is used.
+func NewComposer(repo *git.Repository, owner, repoName string, ghClient PRClient, taxonomy []SectionRule) *Composer {
+	if taxonomy == nil {
+		taxonomy = DefaultTaxonomy
+	}
+	return &Composer{
+		repo:     repo,
+		owner:    owner,
+		repoName: repoName,
+		ghClient: ghClient,
+		taxonomy: taxonomy,
+	}
+}
+
+// Generate walks the commit range (fromRef, toRef] and composes a changelog from it. toRef may be
+// empty, in which case HEAD is used; fromRef must always be set (e.g. to the previous release
+// tag), since an empty fromRef has no sensible default and would silently produce zero commits.
+func (c *Composer) Generate(ctx context.Context, fromRef, toRef string) (*ComposedChangelog, error) {
+	if fromRef == "" {
+		return nil, MissingFromRefError
+	}
+	toHash, err := c.resolveRef(toRef)
+	if err != nil {
+		return nil, err
+	}
+	fromHash, err := c.resolveRef(fromRef)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := c.commitsBetween(fromHash, toHash)
+	if err != nil {
+		return nil, err
+	}
+
+	changelog := &ComposedChangelog{
+		Owner:    c.owner,
+		Repo:     c.repoName,
+		FromRef:  fromRef,
+		ToRef:    toRef,
+		Sections: map[Section][]Entry{},
+	}
+	contributors := map[string]struct{}{}
+
+	for _, commit := range commits {
+		prNumber, ok := extractPRNumber(commit.Message)
+		entry := Entry{
+			CommitSHA: commit.Hash.String(),
+			Section:   SectionOther,
+		}
+		if !ok {
+			// No associated PR; fall back to the raw commit subject so nothing is silently dropped.
+			entry.Title = firstLine(commit.Message)
+			entry.Author = commit.Author.Name
+			changelog.Sections[entry.Section] = append(changelog.Sections[entry.Section], entry)
+			continue
+		}
+
+		pr, err := c.ghClient.GetPR(ctx, prNumber)
+		if err != nil {
+			// Degrade gracefully: a merge commit whose PR can no longer be fetched still
+			// produces an entry, just without the richer PR metadata.
+			entry.PRNumber = prNumber
+			entry.Title = firstLine(commit.Message)
+			changelog.Sections[entry.Section] = append(changelog.Sections[entry.Section], entry)
+			continue
+		}
+
+		entry.PRNumber = pr.Number
+		entry.Title = pr.Title
+		entry.Body = pr.Body
+		entry.Author = pr.Author
+		entry.Labels = pr.Labels
+		entry.Section = classify(pr.Title, pr.Body, pr.Labels, c.taxonomy)
+		changelog.Sections[entry.Section] = append(changelog.Sections[entry.Section], entry)
+
+		if pr.Author != "" {
+			contributors[pr.Author] = struct{}{}
+		}
+	}
+
+	for author := range contributors {
+		changelog.Contributors = append(changelog.Contributors, author)
+	}
+
+	return changelog, nil
+}
+
+func (c *Composer) resolveRef(ref string) (plumbing.Hash, error) {
+	if ref == "" {
+		head, err := c.repo.Head()
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		return head.Hash(), nil
+	}
+	hash, err := c.repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return plumbing.ZeroHash, NoSuchTagError(ref)
+	}
+	return *hash, nil
+}
+
+// commitsBetween returns the commits reachable from `to` but not from `from`, oldest first.
+func (c *Composer) commitsBetween(from, to plumbing.Hash) ([]*object.Commit, error) {
+	excluded := map[plumbing.Hash]struct{}{}
+	if from != plumbing.ZeroHash {
+		ancestors, err := c.repo.Log(&git.LogOptions{From: from})
+		if err != nil {
+			return nil, err
+		}
+		err = ancestors.ForEach(func(commit *object.Commit) error {
+			excluded[commit.Hash] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	iter, err := c.repo.Log(&git.LogOptions{From: to})
+	if err != nil {
+		return nil, err
+	}
+	var commits []*object.Commit
+	err = iter.ForEach(func(commit *object.Commit) error {
+		if _, ok := excluded[commit.Hash]; ok {
+			return nil
+		}
+		commits = append(commits, commit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Log walks newest-first; reverse so entries render in chronological order.
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+	return commits, nil
+}
+
+func extractPRNumber(commitMessage string) (int, bool) {
+	if match := mergeCommitPRRegex.FindStringSubmatch(commitMessage); match != nil {
+		return atoiOrZero(match[1]), true
+	}
+	if match := squashCommitPRRegex.FindStringSubmatch(firstLine(commitMessage)); match != nil {
+		return atoiOrZero(match[1]), true
+	}
+	return 0, false
+}
+
+func classify(title, body string, labels []string, taxonomy []SectionRule) Section {
+	for _, rule := range taxonomy {
+		for _, label := range labels {
+			if label == rule.Prefix {
+				return rule.Section
+			}
+		}
+		if hasPrefix(title, rule.Prefix) {
+			return rule.Section
+		}
+		if bodyHasPrefixLine(body, rule.Prefix) {
+			return rule.Section
+		}
+	}
+	return SectionOther
+}
+
+// bodyHasPrefixLine reports whether any line of body starts with prefix. Conventional-Commits
+// markers like "BREAKING CHANGE:" are written as a footer line in the PR body, not the title.
+func bodyHasPrefixLine(body, prefix string) bool {
+	for _, line := range strings.Split(body, "\n") {
+		if hasPrefix(strings.TrimSpace(line), prefix) {
+			return true
+		}
+	}
+	return false
+}
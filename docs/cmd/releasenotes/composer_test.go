@@ -0,0 +1,91 @@
+package releasenotes
+
+import (
+	"context"
+	"testing"
+)
+
+type stubPRClient struct {
+	prs map[int]*PullRequest
+}
+
+func (s *stubPRClient) GetPR(ctx context.Context, number int) (*PullRequest, error) {
+	pr, ok := s.prs[number]
+	if !ok {
+		return nil, errNotFound
+	}
+	return pr, nil
+}
+
+var errNotFound = &stubError{"pr not found"}
+
+type stubError struct{ msg string }
+
+func (e *stubError) Error() string { return e.msg }
+
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		name   string
+		title  string
+		body   string
+		labels []string
+		want   Section
+	}{
+		{"feat label", "add widget", "", []string{":sparkles:"}, SectionFeatures},
+		{"feat prefix", "feat: add widget", "", nil, SectionFeatures},
+		{"fix prefix", "fix: nil pointer", "", nil, SectionBugFixes},
+		{"breaking title prefix", "BREAKING CHANGE: remove flag", "", nil, SectionBreaking},
+		{"breaking body footer", "fix: bump timeout", "Bumps the default timeout.\n\nBREAKING CHANGE: the timeout flag now takes a duration, not seconds.", nil, SectionBreaking},
+		{"infra label", "bump deps", "", []string{":seedling:"}, SectionInfra},
+		{"unrecognized", "tidy up", "", nil, SectionOther},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := classify(c.title, c.body, c.labels, DefaultTaxonomy)
+			if got != c.want {
+				t.Errorf("classify(%q, %q, %v) = %v, want %v", c.title, c.body, c.labels, got, c.want)
+			}
+		})
+	}
+}
+
+func TestExtractPRNumber(t *testing.T) {
+	cases := []struct {
+		message string
+		wantNum int
+		wantOk  bool
+	}{
+		{"Merge pull request #123 from solo-io/foo", 123, true},
+		{"add widget (#456)", 456, true},
+		{"just a regular commit", 0, false},
+	}
+	for _, c := range cases {
+		num, ok := extractPRNumber(c.message)
+		if ok != c.wantOk || num != c.wantNum {
+			t.Errorf("extractPRNumber(%q) = (%d, %v), want (%d, %v)", c.message, num, ok, c.wantNum, c.wantOk)
+		}
+	}
+}
+
+func TestGenerateRequiresFromRef(t *testing.T) {
+	composer := NewComposer(nil, "solo-io", "gloo", &stubPRClient{}, nil)
+	if _, err := composer.Generate(context.Background(), "", "HEAD"); err != MissingFromRefError {
+		t.Errorf("expected MissingFromRefError, got %v", err)
+	}
+}
+
+func TestStubPRClient(t *testing.T) {
+	client := &stubPRClient{prs: map[int]*PullRequest{
+		1: {Number: 1, Title: "feat: add widget", Author: "alice"},
+	}}
+	pr, err := client.GetPR(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pr.Title != "feat: add widget" {
+		t.Errorf("unexpected PR title: %v", pr.Title)
+	}
+	if _, err := client.GetPR(context.Background(), 2); err == nil {
+		t.Error("expected error fetching unknown PR, got nil")
+	}
+}
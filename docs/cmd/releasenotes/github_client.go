@@ -0,0 +1,51 @@
+package releasenotes
+
+import (
+	"context"
+
+	"github.com/google/go-github/v32/github"
+)
+
+// PullRequest is the subset of GitHub PR metadata the composer needs to render an entry.
+type PullRequest struct {
+	Number int
+	Title  string
+	Body   string
+	Author string
+	Labels []string
+}
+
+// PRClient fetches pull request metadata. It is implemented by githubPRClient against the real
+// GitHub API, and stubbed out in tests.
+type PRClient interface {
+	GetPR(ctx context.Context, number int) (*PullRequest, error)
+}
+
+type githubPRClient struct {
+	client *github.Client
+	owner  string
+	repo   string
+}
+
+// NewGithubPRClient returns a PRClient backed by the real GitHub API.
+func NewGithubPRClient(client *github.Client, owner, repo string) PRClient {
+	return &githubPRClient{client: client, owner: owner, repo: repo}
+}
+
+func (g *githubPRClient) GetPR(ctx context.Context, number int) (*PullRequest, error) {
+	pr, _, err := g.client.PullRequests.Get(ctx, g.owner, g.repo, number)
+	if err != nil {
+		return nil, err
+	}
+	var labels []string
+	for _, label := range pr.Labels {
+		labels = append(labels, label.GetName())
+	}
+	return &PullRequest{
+		Number: pr.GetNumber(),
+		Title:  pr.GetTitle(),
+		Body:   pr.GetBody(),
+		Author: pr.GetUser().GetLogin(),
+		Labels: labels,
+	}, nil
+}
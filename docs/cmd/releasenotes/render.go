@@ -0,0 +1,67 @@
+package releasenotes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// sectionOrder controls the order sections are rendered in, regardless of map iteration order.
+var sectionOrder = []Section{
+	SectionBreaking,
+	SectionFeatures,
+	SectionBugFixes,
+	SectionInfra,
+	SectionOther,
+}
+
+// RenderMarkdown renders a ComposedChangelog as a grouped Markdown document with a contributor list.
+func RenderMarkdown(changelog *ComposedChangelog) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## %s...%s\n\n", changelog.FromRef, changelog.ToRef)
+
+	for _, section := range sectionOrder {
+		entries := changelog.Sections[section]
+		if len(entries) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "### %s\n\n", section)
+		for _, entry := range entries {
+			if entry.PRNumber != 0 {
+				if changelog.Owner != "" && changelog.Repo != "" {
+					fmt.Fprintf(&sb, "- %s ([#%d](https://github.com/%s/%s/pull/%d))", entry.Title, entry.PRNumber, changelog.Owner, changelog.Repo, entry.PRNumber)
+				} else {
+					fmt.Fprintf(&sb, "- %s (#%d)", entry.Title, entry.PRNumber)
+				}
+			} else {
+				fmt.Fprintf(&sb, "- %s", entry.Title)
+			}
+			if entry.Author != "" {
+				fmt.Fprintf(&sb, " - @%s", entry.Author)
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(changelog.Contributors) > 0 {
+		contributors := append([]string(nil), changelog.Contributors...)
+		sort.Strings(contributors)
+		sb.WriteString("### Contributors\n\n")
+		for _, contributor := range contributors {
+			fmt.Fprintf(&sb, "- @%s\n", contributor)
+		}
+	}
+
+	return sb.String()
+}
+
+// RenderJSON renders a ComposedChangelog as indented, machine-readable JSON.
+func RenderJSON(changelog *ComposedChangelog) (string, error) {
+	out, err := json.MarshalIndent(changelog, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
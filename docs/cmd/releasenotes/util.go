@@ -0,0 +1,25 @@
+package releasenotes
+
+import (
+	"strconv"
+	"strings"
+)
+
+func firstLine(s string) string {
+	if idx := strings.IndexByte(s, '\n'); idx >= 0 {
+		return s[:idx]
+	}
+	return s
+}
+
+func hasPrefix(s, prefix string) bool {
+	return strings.HasPrefix(strings.TrimSpace(s), prefix)
+}
+
+func atoiOrZero(s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,115 @@
+package securityscanutils
+
+import (
+	"context"
+	"io/ioutil"
+	"sync"
+
+	"cloud.google.com/go/storage"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/docs/cmd/ghclient"
+)
+
+const (
+	glooScanBucket  = "gloo-security-scans"
+	glooEScanBucket = "gloo-ee-security-scans"
+)
+
+// BuildOptions configures how scan reports are located and parsed for a set of releases.
+type BuildOptions struct {
+	// Format selects the parser used for each release's scan output. Defaults to FormatLegacy.
+	Format Format
+	// OutputDir is the directory the per-release Markdown and aggregated JSON index are written to.
+	OutputDir string
+	// Concurrency bounds how many releases' scan output are fetched from the bucket at once.
+	Concurrency int
+}
+
+// releaseObjectPath returns the single scan report object for a release in the given format: the
+// legacy report sits at the release root, and SARIF and CycloneDX each get their own subdirectory
+// under the release, so legacy and new-format scans can coexist during a migration.
+func releaseObjectPath(tagName string, format Format) string {
+	switch format {
+	case FormatSarif:
+		return tagName + "/sarif/" + tagName + ".sarif.json"
+	case FormatCycloneDX:
+		return tagName + "/cyclonedx/" + tagName + ".cdx.json"
+	default:
+		return tagName + "/security_scan.txt"
+	}
+}
+
+// BuildSecurityScanReportGloo renders the security scan docs for the given Gloo (open source)
+// release tags, reading scan output from the glooScanBucket GCS bucket.
+func BuildSecurityScanReportGloo(tagNames []string, opts BuildOptions) error {
+	return buildSecurityScanReport(context.Background(), glooScanBucket, tagNames, opts)
+}
+
+// BuildSecurityScanReportGlooE renders the security scan docs for the given Gloo Enterprise
+// release tags, reading scan output from the glooEScanBucket GCS bucket.
+func BuildSecurityScanReportGlooE(tagNames []string, opts BuildOptions) error {
+	return buildSecurityScanReport(context.Background(), glooEScanBucket, tagNames, opts)
+}
+
+func buildSecurityScanReport(ctx context.Context, bucketName string, tagNames []string, opts BuildOptions) error {
+	parser, err := ParserFor(opts.Format)
+	if err != nil {
+		return err
+	}
+
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	bucket := client.Bucket(bucketName)
+
+	releaseByTag := make(map[string]ReleaseReport, len(tagNames))
+	var mu sync.Mutex
+	err = ghclient.ForEachTag(ctx, tagNames, opts.Concurrency, func(ctx context.Context, tagName string) error {
+		data, err := readBucketObject(ctx, bucket, releaseObjectPath(tagName, opts.Format))
+		if err != nil {
+			// A missing scan for one release shouldn't fail the whole docs build.
+			return nil
+		}
+		reports, err := parser.Parse(tagName, data)
+		if err != nil {
+			return eris.Wrapf(err, "parsing %s scan report for %s", opts.Format, tagName)
+		}
+		mu.Lock()
+		releaseByTag[tagName] = ReleaseReport{TagName: tagName, Reports: reports}
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var releases []ReleaseReport
+	for _, tagName := range tagNames {
+		if release, ok := releaseByTag[tagName]; ok {
+			releases = append(releases, release)
+		}
+	}
+
+	if opts.OutputDir == "" {
+		return eris.New("OutputDir must be set")
+	}
+	if err := ioutil.WriteFile(opts.OutputDir+"/security_scans.md", []byte(RenderMarkdown(releases)), 0644); err != nil {
+		return err
+	}
+	indexJSON, err := RenderJSONIndex(releases)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(opts.OutputDir+"/security_scans.json", []byte(indexJSON), 0644)
+}
+
+func readBucketObject(ctx context.Context, bucket *storage.BucketHandle, objectPath string) ([]byte, error) {
+	reader, err := bucket.Object(objectPath).NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
@@ -0,0 +1,68 @@
+package securityscanutils
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// cyclonedxParser parses CycloneDX JSON output, as emitted by Trivy/Syft when configured for the
+// `cyclonedx` format. Only the fields needed to populate a ScanReport are modeled.
+type cyclonedxParser struct{}
+
+type cyclonedxBOM struct {
+	Vulnerabilities []struct {
+		ID      string `json:"id"`
+		Affects []struct {
+			Ref string `json:"ref"`
+		} `json:"affects"`
+		Ratings []struct {
+			Score    float64 `json:"score"`
+			Severity string  `json:"severity"`
+		} `json:"ratings"`
+	} `json:"vulnerabilities"`
+	Components []struct {
+		BomRef  string `json:"bom-ref"`
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"components"`
+}
+
+func (cyclonedxParser) Parse(image string, data []byte) ([]ScanReport, error) {
+	var bom cyclonedxBOM
+	if err := json.Unmarshal(data, &bom); err != nil {
+		return nil, err
+	}
+
+	componentsByRef := make(map[string]struct {
+		Name    string
+		Version string
+	}, len(bom.Components))
+	for _, component := range bom.Components {
+		componentsByRef[component.BomRef] = struct {
+			Name    string
+			Version string
+		}{Name: component.Name, Version: component.Version}
+	}
+
+	var reports []ScanReport
+	for _, vuln := range bom.Vulnerabilities {
+		report := ScanReport{
+			Image:        image,
+			CVE:          vuln.ID,
+			SourceFormat: FormatCycloneDX,
+			Severity:     SeverityUnknown,
+		}
+		if len(vuln.Ratings) > 0 {
+			report.CVSS = vuln.Ratings[0].Score
+			report.Severity = Severity(strings.ToUpper(vuln.Ratings[0].Severity))
+		}
+		if len(vuln.Affects) > 0 {
+			if component, ok := componentsByRef[vuln.Affects[0].Ref]; ok {
+				report.Package = component.Name
+				report.InstalledVersion = component.Version
+			}
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
@@ -0,0 +1,40 @@
+package securityscanutils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// legacyParser parses the original plaintext per-release scan report: one finding per line,
+// pipe-delimited as `package|installedVersion|fixedVersion|cve|severity|cvss`.
+type legacyParser struct{}
+
+func (legacyParser) Parse(image string, data []byte) ([]ScanReport, error) {
+	var reports []ScanReport
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "|")
+		if len(fields) < 5 {
+			continue
+		}
+		report := ScanReport{
+			Image:            image,
+			Package:          fields[0],
+			InstalledVersion: fields[1],
+			FixedVersion:     fields[2],
+			CVE:              fields[3],
+			Severity:         Severity(strings.ToUpper(fields[4])),
+			SourceFormat:     FormatLegacy,
+		}
+		if len(fields) >= 6 {
+			if cvss, err := strconv.ParseFloat(fields[5], 64); err == nil {
+				report.CVSS = cvss
+			}
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
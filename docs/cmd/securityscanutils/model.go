@@ -0,0 +1,39 @@
+// Package securityscanutils renders per-release security scan docs from scan reports stored in
+// a GCS bucket, one directory per release.
+package securityscanutils
+
+// Severity is a normalized vulnerability severity, independent of the source scan format.
+type Severity string
+
+const (
+	SeverityCritical Severity = "CRITICAL"
+	SeverityHigh     Severity = "HIGH"
+	SeverityMedium   Severity = "MEDIUM"
+	SeverityLow      Severity = "LOW"
+	SeverityUnknown  Severity = "UNKNOWN"
+)
+
+// Format identifies the on-disk shape a scan report was parsed from.
+type Format string
+
+const (
+	// FormatLegacy is the plaintext, pipe-delimited per-release report read by legacyParser.
+	FormatLegacy Format = "legacy"
+	// FormatSarif is SARIF 2.1.0 JSON, as emitted by Trivy, Grype, and most other scanners.
+	FormatSarif Format = "sarif"
+	// FormatCycloneDX is CycloneDX JSON, as emitted by Trivy and Syft.
+	FormatCycloneDX Format = "cyclonedx"
+)
+
+// ScanReport is a single vulnerability finding, normalized across source formats so the renderer
+// does not need to know which scanner or format produced it.
+type ScanReport struct {
+	Image            string   `json:"image"`
+	Package          string   `json:"package"`
+	InstalledVersion string   `json:"installedVersion"`
+	FixedVersion     string   `json:"fixedVersion,omitempty"`
+	CVE              string   `json:"cve"`
+	CVSS             float64  `json:"cvss,omitempty"`
+	Severity         Severity `json:"severity"`
+	SourceFormat     Format   `json:"sourceFormat"`
+}
@@ -0,0 +1,27 @@
+package securityscanutils
+
+import "github.com/rotisserie/eris"
+
+// Parser turns raw scan output for a single image into a normalized slice of ScanReport.
+type Parser interface {
+	Parse(image string, data []byte) ([]ScanReport, error)
+}
+
+// UnsupportedFormatError is returned when a --format value has no registered Parser.
+var UnsupportedFormatError = func(format Format) error {
+	return eris.Errorf("unsupported security scan format %q, must be one of: legacy, sarif, cyclonedx", format)
+}
+
+// ParserFor returns the Parser registered for format.
+func ParserFor(format Format) (Parser, error) {
+	switch format {
+	case FormatLegacy, "":
+		return &legacyParser{}, nil
+	case FormatSarif:
+		return &sarifParser{}, nil
+	case FormatCycloneDX:
+		return &cyclonedxParser{}, nil
+	default:
+		return nil, UnsupportedFormatError(format)
+	}
+}
@@ -0,0 +1,110 @@
+package securityscanutils
+
+import "testing"
+
+func TestLegacyParser(t *testing.T) {
+	data := []byte("openssl|1.1.1|1.1.1n|CVE-2022-0000|HIGH|7.5\n")
+	reports, err := (legacyParser{}).Parse("gloo:1.0.0", data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(reports))
+	}
+	got := reports[0]
+	if got.Package != "openssl" || got.CVE != "CVE-2022-0000" || got.Severity != SeverityHigh || got.CVSS != 7.5 {
+		t.Errorf("unexpected report: %+v", got)
+	}
+}
+
+func TestParserForUnsupportedFormat(t *testing.T) {
+	if _, err := ParserFor("xml"); err == nil {
+		t.Error("expected error for unsupported format, got nil")
+	}
+}
+
+// sarifFixture is a trimmed-down sample of Trivy's `--format sarif` output: installed/fixed
+// versions live in the result's message text, and severity is carried via properties.tags /
+// security-severity rather than a flat score field.
+const sarifFixture = `{
+	"version": "2.1.0",
+	"runs": [
+		{
+			"results": [
+				{
+					"ruleId": "CVE-2022-0000",
+					"level": "error",
+					"message": {
+						"text": "Package: openssl\nInstalled Version: 1.1.1k\nVulnerability CVE-2022-0000\nSeverity: HIGH\nFixed Version: 1.1.1n\nLink: [CVE-2022-0000](https://example.com/CVE-2022-0000)"
+					},
+					"locations": [
+						{
+							"physicalLocation": {
+								"artifactLocation": { "uri": "usr/lib/libssl.so" }
+							}
+						}
+					],
+					"properties": {
+						"tags": ["vulnerability", "security", "HIGH"],
+						"security-severity": "7.5"
+					}
+				}
+			]
+		}
+	]
+}`
+
+func TestSarifParser(t *testing.T) {
+	reports, err := (sarifParser{}).Parse("gloo:1.0.0", []byte(sarifFixture))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(reports))
+	}
+	got := reports[0]
+	if got.CVE != "CVE-2022-0000" || got.Package != "usr/lib/libssl.so" {
+		t.Errorf("unexpected report: %+v", got)
+	}
+	if got.Severity != SeverityHigh {
+		t.Errorf("expected severity HIGH, got %v", got.Severity)
+	}
+	if got.CVSS != 7.5 {
+		t.Errorf("expected CVSS 7.5, got %v", got.CVSS)
+	}
+	if got.InstalledVersion != "1.1.1k" || got.FixedVersion != "1.1.1n" {
+		t.Errorf("unexpected versions: installed=%q fixed=%q", got.InstalledVersion, got.FixedVersion)
+	}
+}
+
+// cyclonedxFixture is a trimmed-down sample of a CycloneDX vulnerability BOM, as emitted by
+// Trivy/Syft when configured for the `cyclonedx` format.
+const cyclonedxFixture = `{
+	"vulnerabilities": [
+		{
+			"id": "CVE-2022-0000",
+			"affects": [{ "ref": "pkg:openssl" }],
+			"ratings": [{ "score": 7.5, "severity": "high" }]
+		}
+	],
+	"components": [
+		{ "bom-ref": "pkg:openssl", "name": "openssl", "version": "1.1.1k" }
+	]
+}`
+
+func TestCycloneDXParser(t *testing.T) {
+	reports, err := (cyclonedxParser{}).Parse("gloo:1.0.0", []byte(cyclonedxFixture))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected 1 report, got %d", len(reports))
+	}
+	got := reports[0]
+	if got.CVE != "CVE-2022-0000" || got.Package != "openssl" || got.InstalledVersion != "1.1.1k" {
+		t.Errorf("unexpected report: %+v", got)
+	}
+	if got.Severity != SeverityHigh || got.CVSS != 7.5 {
+		t.Errorf("unexpected severity/cvss: %+v", got)
+	}
+}
@@ -0,0 +1,145 @@
+package securityscanutils
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-github/v32/github"
+	"github.com/solo-io/gloo/docs/cmd/ghclient"
+	"gopkg.in/yaml.v2"
+)
+
+// metadataAssetName is the release asset a ReleaseSelector downloads to confirm a tag was
+// actually released (as opposed to pushed and never released) and to read its API contract.
+const metadataAssetName = "metadata.yaml"
+
+type releaseMetadata struct {
+	APIContract string `yaml:"apiContract"`
+}
+
+// ReleaseSelector decides which releases of a repo should have docs generated for them: it
+// applies a semver constraint, optionally requires a matching API contract, and can exclude
+// prereleases and unreleased/yanked tags.
+type ReleaseSelector struct {
+	// Constraint restricts selection to matching versions, e.g. ">= 1.4.0".
+	Constraint *semver.Constraints
+	// Contract, if set, is additionally matched against each release's metadata.yaml
+	// `apiContract` field.
+	Contract string
+	// IncludePrereleases controls whether prerelease tags are selected.
+	IncludePrereleases bool
+	// Concurrency bounds how many metadata.yaml lookups run at once.
+	Concurrency int
+
+	client *github.Client
+	owner  string
+	repo   string
+}
+
+// NewReleaseSelector constructs a ReleaseSelector. constraint may be empty to select all versions.
+func NewReleaseSelector(client *github.Client, owner, repo, constraint, contract string, includePrereleases bool, concurrency int) (*ReleaseSelector, error) {
+	selector := &ReleaseSelector{
+		Contract:           contract,
+		IncludePrereleases: includePrereleases,
+		Concurrency:        concurrency,
+		client:             client,
+		owner:              owner,
+		repo:               repo,
+	}
+	if constraint != "" {
+		parsed, err := semver.NewConstraint(constraint)
+		if err != nil {
+			return nil, err
+		}
+		selector.Constraint = parsed
+	}
+	return selector, nil
+}
+
+// Select filters releases down to the tag names that match the selector's constraint and
+// prerelease settings, always skipping any tag whose metadata.yaml asset is missing (which
+// indicates the tag was pushed but never actually released), and additionally requiring a
+// matching API contract when Contract is set. Candidates are checked in their original order,
+// but the metadata.yaml lookups run concurrently, bounded by Concurrency.
+func (s *ReleaseSelector) Select(ctx context.Context, releases []*github.RepositoryRelease) ([]string, error) {
+	var candidates []*github.RepositoryRelease
+	for _, release := range releases {
+		if !s.IncludePrereleases && release.GetPrerelease() {
+			continue
+		}
+		version, err := semver.NewVersion(release.GetTagName())
+		if err != nil {
+			continue
+		}
+		if s.Constraint != nil && !s.Constraint.Check(version) {
+			continue
+		}
+		candidates = append(candidates, release)
+	}
+
+	var mu sync.Mutex
+	matched := map[string]bool{}
+	err := ghclient.ForEachRelease(ctx, candidates, s.Concurrency, func(ctx context.Context, release *github.RepositoryRelease) error {
+		metadata, ok, err := s.fetchMetadata(ctx, release)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		if s.Contract != "" && metadata.APIContract != s.Contract {
+			return nil
+		}
+		mu.Lock()
+		matched[release.GetTagName()] = true
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var tagNames []string
+	for _, release := range candidates {
+		if matched[release.GetTagName()] {
+			tagNames = append(tagNames, release.GetTagName())
+		}
+	}
+	return tagNames, nil
+}
+
+// fetchMetadata downloads and parses the metadata.yaml asset for release. The second return
+// value is false (with a nil error) when the asset is missing, which indicates the tag was
+// pushed but never actually released.
+func (s *ReleaseSelector) fetchMetadata(ctx context.Context, release *github.RepositoryRelease) (*releaseMetadata, bool, error) {
+	var assetID int64
+	for _, asset := range release.Assets {
+		if asset.GetName() == metadataAssetName {
+			assetID = asset.GetID()
+			break
+		}
+	}
+	if assetID == 0 {
+		return nil, false, nil
+	}
+
+	rc, _, err := s.client.Repositories.DownloadReleaseAsset(ctx, s.owner, s.repo, assetID, http.DefaultClient)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rc.Close()
+
+	body, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var metadata releaseMetadata
+	if err := yaml.Unmarshal(body, &metadata); err != nil {
+		return nil, false, err
+	}
+	return &metadata, true, nil
+}
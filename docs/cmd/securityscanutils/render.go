@@ -0,0 +1,53 @@
+package securityscanutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ReleaseReport groups all findings discovered for a single release/tag.
+type ReleaseReport struct {
+	TagName string       `json:"tagName"`
+	Reports []ScanReport `json:"reports"`
+}
+
+// renderReleaseMarkdown renders a single release's findings as a Markdown table.
+func renderReleaseMarkdown(release ReleaseReport) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## %s\n\n", release.TagName)
+	if len(release.Reports) == 0 {
+		sb.WriteString("No vulnerabilities found.\n\n")
+		return sb.String()
+	}
+
+	sb.WriteString("| Image | Package | Installed | Fixed | CVE | Severity | CVSS |\n")
+	sb.WriteString("|---|---|---|---|---|---|---|\n")
+	for _, report := range release.Reports {
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s | %.1f |\n",
+			report.Image, report.Package, report.InstalledVersion, report.FixedVersion,
+			report.CVE, report.Severity, report.CVSS)
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// RenderMarkdown renders the full set of per-release reports as a single Markdown document,
+// one section per release in the order given.
+func RenderMarkdown(releases []ReleaseReport) string {
+	var sb strings.Builder
+	for _, release := range releases {
+		sb.WriteString(renderReleaseMarkdown(release))
+	}
+	return sb.String()
+}
+
+// RenderJSONIndex renders the full set of per-release reports as a single aggregated,
+// machine-readable JSON index.
+func RenderJSONIndex(releases []ReleaseReport) (string, error) {
+	out, err := json.MarshalIndent(releases, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
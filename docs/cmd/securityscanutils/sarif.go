@@ -0,0 +1,125 @@
+package securityscanutils
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// sarifParser parses SARIF 2.1.0 output, as emitted by Trivy/Grype when configured for the
+// `sarif` format. Only the fields needed to populate a ScanReport are modeled.
+type sarifParser struct{}
+
+type sarifLog struct {
+	Runs []struct {
+		Results []struct {
+			RuleID  string `json:"ruleId"`
+			Level   string `json:"level"`
+			Message struct {
+				Text string `json:"text"`
+			} `json:"message"`
+			Locations []struct {
+				PhysicalLocation struct {
+					ArtifactLocation struct {
+						URI string `json:"uri"`
+					} `json:"artifactLocation"`
+				} `json:"physicalLocation"`
+			} `json:"locations"`
+			// Properties carries the scanner's own severity tagging (tags, security-severity),
+			// not a flat CVSS float - see severityFromProperties/cvssFromProperties.
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+// installedVersionLine and fixedVersionLine match the "Installed Version: x" / "Fixed Version: y"
+// lines Trivy's SARIF template embeds in each result's message text; SARIF has no dedicated field
+// for either, so they aren't available as properties.
+var (
+	installedVersionLine = regexp.MustCompile(`(?m)^Installed Version:\s*(.+)$`)
+	fixedVersionLine     = regexp.MustCompile(`(?m)^Fixed Version:\s*(.+)$`)
+)
+
+func (sarifParser) Parse(image string, data []byte) ([]ScanReport, error) {
+	var log sarifLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		return nil, err
+	}
+
+	var reports []ScanReport
+	for _, run := range log.Runs {
+		for _, result := range run.Results {
+			report := ScanReport{
+				Image:        image,
+				CVE:          result.RuleID,
+				Severity:     severityFromProperties(result.Properties, result.Level),
+				SourceFormat: FormatSarif,
+			}
+			if len(result.Locations) > 0 {
+				report.Package = result.Locations[0].PhysicalLocation.ArtifactLocation.URI
+			}
+			report.InstalledVersion, report.FixedVersion = versionsFromMessage(result.Message.Text)
+			if cvss, ok := cvssFromProperties(result.Properties); ok {
+				report.CVSS = cvss
+			}
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+// severityFromProperties reads the scanner's own severity tag out of properties.tags (the
+// convention Trivy/Grype actually use), falling back to the generic SARIF level only when no
+// recognizable severity tag is present.
+func severityFromProperties(properties map[string]interface{}, level string) Severity {
+	tags, _ := properties["tags"].([]interface{})
+	for _, tag := range tags {
+		tagStr, ok := tag.(string)
+		if !ok {
+			continue
+		}
+		switch strings.ToUpper(tagStr) {
+		case string(SeverityCritical), string(SeverityHigh), string(SeverityMedium), string(SeverityLow):
+			return Severity(strings.ToUpper(tagStr))
+		}
+	}
+	return sarifLevelToSeverity(level)
+}
+
+// cvssFromProperties reads properties["security-severity"], the SARIF-standard field scanners use
+// to carry a CVSS score. It's documented as a string, but tolerate a bare number too.
+func cvssFromProperties(properties map[string]interface{}) (float64, bool) {
+	switch v := properties["security-severity"].(type) {
+	case string:
+		score, err := strconv.ParseFloat(v, 64)
+		return score, err == nil
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func versionsFromMessage(message string) (installed, fixed string) {
+	if match := installedVersionLine.FindStringSubmatch(message); match != nil {
+		installed = strings.TrimSpace(match[1])
+	}
+	if match := fixedVersionLine.FindStringSubmatch(message); match != nil {
+		fixed = strings.TrimSpace(match[1])
+	}
+	return installed, fixed
+}
+
+func sarifLevelToSeverity(level string) Severity {
+	switch level {
+	case "error":
+		return SeverityHigh
+	case "warning":
+		return SeverityMedium
+	case "note":
+		return SeverityLow
+	default:
+		return SeverityUnknown
+	}
+}
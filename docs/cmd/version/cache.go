@@ -0,0 +1,57 @@
+package version
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const cacheTTL = 24 * time.Hour
+
+// cacheEntry is the on-disk representation of the last "newer version available" check.
+type cacheEntry struct {
+	CheckedAt   time.Time `json:"checkedAt"`
+	LatestTag   string    `json:"latestTag"`
+	NewerExists bool      `json:"newerExists"`
+}
+
+// CachePath returns the path to the version-check cache file, honoring $XDG_CACHE_HOME.
+func CachePath() (string, error) {
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		cacheHome = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(cacheHome, "gloo-docs-util", "version.json"), nil
+}
+
+func readCache(path string) (*cacheEntry, bool) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if time.Since(entry.CheckedAt) > cacheTTL {
+		return nil, false
+	}
+	return &entry, true
+}
+
+func writeCache(path string, entry *cacheEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,68 @@
+package version
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// SkipEnvVar disables the newer-version check when set to any non-empty value.
+	SkipEnvVar = "DOCS_UTIL_SKIP_VERSION_CHECK"
+)
+
+// WarnIfNewerRelease checks (using a 24h on-disk cache) whether a newer release of owner/repo is
+// available, and if so prints a one-line warning to stderr. It never returns an error to the
+// caller, and the GitHub call is bounded by a short timeout, so a failed or slow check is silently
+// skipped and can never hang or break a command invocation.
+func WarnIfNewerRelease(ctx context.Context, owner, repo string) {
+	path, err := CachePath()
+	if err != nil {
+		return
+	}
+	if entry, ok := readCache(path); ok {
+		if entry.NewerExists {
+			printWarning(entry.LatestTag)
+		}
+		return
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	client := github.NewClient(tokenClient(ctx))
+	latest, newer, err := LatestRelease(checkCtx, client, owner, repo)
+	if err != nil {
+		return
+	}
+
+	entry := &cacheEntry{CheckedAt: time.Now(), NewerExists: newer}
+	if latest != nil {
+		entry.LatestTag = latest.Original()
+	}
+	_ = writeCache(path, entry)
+
+	if newer {
+		printWarning(entry.LatestTag)
+	}
+}
+
+// tokenClient returns an http.Client authenticated with GITHUB_TOKEN when set, honoring the
+// unauthenticated rate limit otherwise.
+func tokenClient(ctx context.Context) *http.Client {
+	token := os.Getenv("GITHUB_TOKEN")
+	if token == "" {
+		return nil
+	}
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	return oauth2.NewClient(ctx, ts)
+}
+
+func printWarning(latestTag string) {
+	fmt.Fprintf(os.Stderr, "warning: a newer docs-util release (%s) is available; you are running %s\n", latestTag, Version)
+}
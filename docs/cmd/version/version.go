@@ -0,0 +1,44 @@
+// Package version holds build-time version metadata for the docs-util CLI and a helper for
+// checking whether a newer release is available on GitHub.
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-github/v32/github"
+)
+
+// Version, Commit and Date are set at build time via `-ldflags -X`. They default to "dev" values
+// so local builds still work.
+var (
+	Version = "dev"
+	Commit  = "none"
+	Date    = "unknown"
+)
+
+// String renders the version metadata as a single human-readable line.
+func String() string {
+	return fmt.Sprintf("%s (commit %s, built %s)", Version, Commit, Date)
+}
+
+// LatestRelease fetches the latest release tag for owner/repo and reports whether it is newer
+// than the running binary's Version. If Version is not a valid semver (e.g. "dev"), newer is
+// always false.
+func LatestRelease(ctx context.Context, client *github.Client, owner, repo string) (latest *semver.Version, newer bool, err error) {
+	release, _, err := client.Repositories.GetLatestRelease(ctx, owner, repo)
+	if err != nil {
+		return nil, false, err
+	}
+	latest, err = semver.NewVersion(release.GetTagName())
+	if err != nil {
+		return nil, false, err
+	}
+	current, err := semver.NewVersion(Version)
+	if err != nil {
+		// Running a dev build; nothing to meaningfully compare against.
+		return latest, false, nil
+	}
+	return latest, latest.GreaterThan(current), nil
+}